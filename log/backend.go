@@ -0,0 +1,127 @@
+package log
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Record is a single log event handed to a Backend.
+type Record struct {
+	ID      uint64
+	Time    time.Time
+	Level   Level
+	Module  string
+	Message string
+	Args    []any
+	Caller  string // file:line of the originating call
+}
+
+// Backend receives Records produced by the package's loggers. Backends must
+// be safe for concurrent use.
+type Backend interface {
+	Log(rec *Record) error
+}
+
+var nextRecordID uint64
+
+// defaultCallerSkip is the skip passed to callerString for log calls made
+// through the package-level helpers (Info, Infof, ...). Those add one
+// extra frame over calling a Logger's methods directly (log.Info ->
+// logger.Info -> logf), so this accounts for that wrapper frame.
+const defaultCallerSkip = 5
+
+// moduleCallerSkip is the skip for ModuleLogger, which has no
+// package-level wrapper layer: its methods are called directly.
+const moduleCallerSkip = 4
+
+// newRecord builds a Record for a log call, resolving Caller by walking
+// skip frames up the stack from callerString's own frame.
+func newRecord(lv Level, module, message string, args []any, skip int) *Record {
+	return &Record{
+		ID:      atomic.AddUint64(&nextRecordID, 1),
+		Time:    time.Now(),
+		Level:   lv,
+		Module:  module,
+		Message: message,
+		Args:    args,
+		Caller:  callerString(skip),
+	}
+}
+
+// callerString returns "file:line" for the stack frame skip levels above
+// its own, or "" if it cannot be determined.
+func callerString(skip int) string {
+	_, file, line, ok := runtime.Caller(skip)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", file, line)
+}
+
+type backendEntry struct {
+	backend Backend
+	level   Level
+}
+
+// MultiBackend fans a Record out to every registered backend whose
+// threshold the record's level meets or exceeds.
+type MultiBackend struct {
+	mu      sync.RWMutex
+	entries []backendEntry
+}
+
+// NewMultiBackend returns a MultiBackend with no backends registered.
+func NewMultiBackend() *MultiBackend {
+	return &MultiBackend{}
+}
+
+// Add registers b to receive records at or above minLevel.
+func (m *MultiBackend) Add(b Backend, minLevel Level) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = append(m.entries, backendEntry{backend: b, level: minLevel})
+}
+
+// Reset removes every registered backend.
+func (m *MultiBackend) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = nil
+}
+
+// Log implements Backend by dispatching rec to every entry whose threshold
+// it meets, returning the last error encountered, if any.
+func (m *MultiBackend) Log(rec *Record) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var err error
+	for _, e := range m.entries {
+		if rec.Level < e.level {
+			continue
+		}
+		if logErr := e.backend.Log(rec); logErr != nil {
+			err = logErr
+		}
+	}
+	return err
+}
+
+// backends is the package-level backend chain wired into defaultLogger and
+// ModuleLogger. It starts empty so installing no backend changes nothing.
+var backends = NewMultiBackend()
+
+// SetBackend replaces all registered backends with b, routing it records
+// at every level.
+func SetBackend(b Backend) {
+	backends.Reset()
+	backends.Add(b, DEBUG)
+}
+
+// AddBackend registers b alongside any existing backends, routing it only
+// records at or above minLevel.
+func AddBackend(b Backend, minLevel Level) {
+	backends.Add(b, minLevel)
+}
@@ -0,0 +1,85 @@
+package log
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// Formatter renders a Record into a single log line, without a trailing
+// newline.
+type Formatter func(rec *Record) string
+
+// DefaultFormatter renders a Record the same way the classic Logger does:
+// "[LEVEL] message".
+func DefaultFormatter(rec *Record) string {
+	return rec.Level.String() + rec.Message
+}
+
+// WriterBackend writes formatted Records to an io.Writer, one per line.
+type WriterBackend struct {
+	mu     sync.Mutex
+	w      io.Writer
+	format Formatter
+}
+
+// NewWriterBackend returns a Backend writing to w using formatter. A nil
+// formatter defaults to DefaultFormatter.
+func NewWriterBackend(w io.Writer, formatter Formatter) *WriterBackend {
+	if formatter == nil {
+		formatter = DefaultFormatter
+	}
+	return &WriterBackend{w: w, format: formatter}
+}
+
+func (b *WriterBackend) Log(rec *Record) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_, err := fmt.Fprintln(b.w, b.format(rec))
+	return err
+}
+
+// NewStdoutBackend returns a Backend writing formatted Records to stdout.
+func NewStdoutBackend() Backend {
+	return NewWriterBackend(os.Stdout, nil)
+}
+
+// NewStderrBackend returns a Backend writing formatted Records to stderr.
+func NewStderrBackend() Backend {
+	return NewWriterBackend(os.Stderr, nil)
+}
+
+// MemoryBackend keeps the most recent Records in a ring buffer. It is
+// intended for tests that want to assert on emitted records.
+type MemoryBackend struct {
+	mu      sync.Mutex
+	records []*Record
+	cap     int
+}
+
+// NewMemoryBackend returns a MemoryBackend retaining at most capacity
+// records.
+func NewMemoryBackend(capacity int) *MemoryBackend {
+	return &MemoryBackend{cap: capacity}
+}
+
+func (b *MemoryBackend) Log(rec *Record) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.records = append(b.records, rec)
+	if len(b.records) > b.cap {
+		b.records = b.records[len(b.records)-b.cap:]
+	}
+	return nil
+}
+
+// Records returns a snapshot of the currently retained records, oldest
+// first.
+func (b *MemoryBackend) Records() []*Record {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	out := make([]*Record, len(b.records))
+	copy(out, b.records)
+	return out
+}
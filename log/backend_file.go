@@ -0,0 +1,93 @@
+package log
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// FileBackend writes formatted Records to a file, rotating it once it
+// would grow past maxBytes. Up to maxBackups rotated files (path.1,
+// path.2, ...) are kept; older ones are discarded.
+type FileBackend struct {
+	mu         sync.Mutex
+	path       string
+	maxBytes   int64
+	maxBackups int
+	format     Formatter
+	file       *os.File
+	size       int64
+}
+
+// NewFileBackend opens (creating if necessary) path for appending and
+// returns a Backend that rotates it before a write that would take it past
+// maxBytes. A maxBytes of 0 disables rotation.
+func NewFileBackend(path string, maxBytes int64, maxBackups int) (*FileBackend, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &FileBackend{
+		path:       path,
+		maxBytes:   maxBytes,
+		maxBackups: maxBackups,
+		format:     DefaultFormatter,
+		file:       f,
+		size:       info.Size(),
+	}, nil
+}
+
+func (b *FileBackend) Log(rec *Record) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	line := b.format(rec) + "\n"
+	if b.maxBytes > 0 && b.size+int64(len(line)) > b.maxBytes {
+		if err := b.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := b.file.WriteString(line)
+	b.size += int64(n)
+	return err
+}
+
+// rotate closes the current file, shifts path.1..path.(maxBackups-1) up by
+// one, drops anything beyond maxBackups, and reopens path fresh.
+func (b *FileBackend) rotate() error {
+	if err := b.file.Close(); err != nil {
+		return err
+	}
+	if b.maxBackups > 0 {
+		_ = os.Remove(b.backupPath(b.maxBackups))
+		for i := b.maxBackups - 1; i >= 1; i-- {
+			_ = os.Rename(b.backupPath(i), b.backupPath(i+1))
+		}
+		_ = os.Rename(b.path, b.backupPath(1))
+	} else {
+		_ = os.Remove(b.path)
+	}
+	f, err := os.OpenFile(b.path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	b.file = f
+	b.size = 0
+	return nil
+}
+
+func (b *FileBackend) backupPath(n int) string {
+	return fmt.Sprintf("%s.%d", b.path, n)
+}
+
+// Close closes the underlying file.
+func (b *FileBackend) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.file.Close()
+}
@@ -0,0 +1,41 @@
+package log
+
+import "log/syslog"
+
+// SyslogBackend writes Records to a syslog daemon via log/syslog, mapping
+// this package's levels onto syslog severities.
+type SyslogBackend struct {
+	w *syslog.Writer
+}
+
+// NewSyslogBackend dials a syslog daemon the same way syslog.Dial does:
+// an empty network and raddr connect to the local syslog service.
+func NewSyslogBackend(network, raddr, tag string) (*SyslogBackend, error) {
+	w, err := syslog.Dial(network, raddr, syslog.LOG_INFO|syslog.LOG_USER, tag)
+	if err != nil {
+		return nil, err
+	}
+	return &SyslogBackend{w: w}, nil
+}
+
+func (b *SyslogBackend) Log(rec *Record) error {
+	switch rec.Level {
+	case DEBUG:
+		return b.w.Debug(rec.Message)
+	case INFO:
+		return b.w.Info(rec.Message)
+	case WARN:
+		return b.w.Warning(rec.Message)
+	case ERROR:
+		return b.w.Err(rec.Message)
+	case FATAL:
+		return b.w.Crit(rec.Message)
+	default:
+		return b.w.Notice(rec.Message)
+	}
+}
+
+// Close closes the underlying syslog connection.
+func (b *SyslogBackend) Close() error {
+	return b.w.Close()
+}
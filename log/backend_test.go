@@ -0,0 +1,115 @@
+package log
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiBackendLevelThreshold(t *testing.T) {
+	quiet := NewMemoryBackend(10)
+	loud := NewMemoryBackend(10)
+
+	m := NewMultiBackend()
+	m.Add(quiet, ERROR)
+	m.Add(loud, DEBUG)
+
+	rec := &Record{Level: INFO, Message: "hello"}
+	require.NoError(t, m.Log(rec))
+
+	require.Empty(t, quiet.Records())
+	require.Len(t, loud.Records(), 1)
+	require.Equal(t, "hello", loud.Records()[0].Message)
+}
+
+func TestWriterBackend(t *testing.T) {
+	buf := new(bytes.Buffer)
+	b := NewWriterBackend(buf, nil)
+
+	require.NoError(t, b.Log(&Record{Level: WARN, Message: "careful"}))
+	require.Contains(t, buf.String(), "careful")
+	require.Contains(t, buf.String(), WARN.String())
+}
+
+func TestMemoryBackendRingBuffer(t *testing.T) {
+	b := NewMemoryBackend(2)
+	for i, msg := range []string{"a", "b", "c"} {
+		require.NoError(t, b.Log(&Record{ID: uint64(i), Message: msg}))
+	}
+	got := b.Records()
+	require.Len(t, got, 2)
+	require.Equal(t, "b", got[0].Message)
+	require.Equal(t, "c", got[1].Message)
+}
+
+func TestFileBackendRotation(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "test.log")
+
+	b, err := NewFileBackend(path, 40, 1)
+	require.NoError(t, err)
+	defer b.Close()
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, b.Log(&Record{Level: INFO, Message: "some log line"}))
+	}
+
+	_, err = os.Stat(path + ".1")
+	require.NoError(t, err)
+}
+
+func TestModuleLoggerLevel(t *testing.T) {
+	mem := NewMemoryBackend(10)
+	SetBackend(mem)
+	defer backends.Reset()
+
+	SetModuleLevel("http", ERROR)
+	defer SetModuleLevel("http", defaultModuleLevel)
+
+	h := GetLogger("http")
+	h.Info("ignored")
+	h.Error("surfaced")
+
+	recs := mem.Records()
+	require.Len(t, recs, 1)
+	require.Equal(t, "http", recs[0].Module)
+	require.Equal(t, "surfaced", recs[0].Message)
+}
+
+func TestRecordCallerMatchesCallSite(t *testing.T) {
+	mem := NewMemoryBackend(10)
+	SetBackend(mem)
+	defer backends.Reset()
+	SetLevel(INFO)
+
+	_, wantFile, wantLine, ok := runtime.Caller(0)
+	Info("hi")
+	require.True(t, ok)
+
+	recs := mem.Records()
+	require.Len(t, recs, 1)
+	require.Equal(t, fmt.Sprintf("%s:%d", wantFile, wantLine+1), recs[0].Caller)
+}
+
+func TestModuleLoggerRecordCaller(t *testing.T) {
+	mem := NewMemoryBackend(10)
+	SetBackend(mem)
+	defer backends.Reset()
+
+	SetModuleLevel("http", DEBUG)
+	defer SetModuleLevel("http", defaultModuleLevel)
+
+	h := GetLogger("http")
+	_, wantFile, wantLine, ok := runtime.Caller(0)
+	h.Info("hi")
+	require.True(t, ok)
+
+	recs := mem.Records()
+	require.Len(t, recs, 1)
+	require.Equal(t, fmt.Sprintf("%s:%d", wantFile, wantLine+1), recs[0].Caller)
+}
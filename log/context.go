@@ -0,0 +1,99 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+type ctxKey struct{}
+
+// NewContext returns a copy of ctx carrying l, retrievable with
+// FromContext.
+func NewContext(ctx context.Context, l Logger) context.Context {
+	return context.WithValue(ctx, ctxKey{}, l)
+}
+
+// FromContext returns the Logger carried by ctx, or the default logger if
+// ctx carries none.
+func FromContext(ctx context.Context) Logger {
+	if l, ok := ctx.Value(ctxKey{}).(Logger); ok {
+		return l
+	}
+	return logger
+}
+
+// ContextExtractor pulls keyval pairs (trace IDs, span IDs, request IDs,
+// ...) out of a context.Context so integrations can feed them to the
+// *Ctx logging methods without touching call sites.
+type ContextExtractor func(ctx context.Context) []any
+
+var contextExtractorRegistry = struct {
+	mu         sync.RWMutex
+	extractors []ContextExtractor
+}{}
+
+// RegisterContextExtractor adds fn to the set of extractors consulted by
+// every *Ctx logging method.
+func RegisterContextExtractor(fn ContextExtractor) {
+	contextExtractorRegistry.mu.Lock()
+	defer contextExtractorRegistry.mu.Unlock()
+	contextExtractorRegistry.extractors = append(contextExtractorRegistry.extractors, fn)
+}
+
+// extractContext runs every registered extractor over ctx and concatenates
+// their keyvals, in registration order.
+func extractContext(ctx context.Context) []any {
+	contextExtractorRegistry.mu.RLock()
+	extractors := contextExtractorRegistry.extractors
+	contextExtractorRegistry.mu.RUnlock()
+	if len(extractors) == 0 {
+		return nil
+	}
+	var keyvals []any
+	for _, fn := range extractors {
+		keyvals = append(keyvals, fn(ctx)...)
+	}
+	return keyvals
+}
+
+// renderKeyvals renders keyvals as " key=value key=value ..." for the
+// classic, unstructured Logger. It returns "" for an empty slice, ignoring
+// a dangling trailing key with no value.
+func renderKeyvals(keyvals []any) string {
+	suffix := ""
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		suffix += fmt.Sprintf(" %v=%v", keyvals[i], keyvals[i+1])
+	}
+	return suffix
+}
+
+// ctxSuffix renders the keyvals extracted from ctx as " key=value key=value"
+// for the classic, unstructured Logger. It returns "" when no extractor is
+// registered or none of them produced anything.
+func ctxSuffix(ctx context.Context) string {
+	return renderKeyvals(extractContext(ctx))
+}
+
+// appendCtxSuffix appends ctx's extracted keyvals to args as a single
+// trailing string, leaving args untouched when there is nothing to add.
+func appendCtxSuffix(ctx context.Context, args []any) []any {
+	suffix := ctxSuffix(ctx)
+	if suffix == "" {
+		return args
+	}
+	return append(args, suffix)
+}
+
+// appendCtxFormatSuffix appends ctx's extracted keyvals to format as a
+// trailing "%v" verb plus a matching arg, rather than concatenating the
+// rendered suffix directly into the format string: an extractor-supplied
+// value containing a literal "%" would otherwise be reparsed as a format
+// verb and corrupt the rest of the message.
+func appendCtxFormatSuffix(ctx context.Context, format string, args []any) (string, []any) {
+	suffix := ctxSuffix(ctx)
+	if suffix == "" {
+		return format, args
+	}
+	return format + "%v", append(args, suffix)
+}
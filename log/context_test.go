@@ -0,0 +1,68 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromContextDefault(t *testing.T) {
+	require.Equal(t, logger, FromContext(context.Background()))
+}
+
+func TestNewContextRoundTrip(t *testing.T) {
+	custom := &defaultLogger{stdLog: logger.(*defaultLogger).stdLog}
+
+	ctx := NewContext(context.Background(), custom)
+	require.Equal(t, Logger(custom), FromContext(ctx))
+}
+
+func TestDebugCtxExtractsFields(t *testing.T) {
+	buf := new(bytes.Buffer)
+	SetOutput(buf)
+	SetLevel(DEBUG)
+	SetFlags(0)
+	SetPrefix("")
+
+	RegisterContextExtractor(func(ctx context.Context) []any {
+		if id, ok := ctx.Value(traceIDKey{}).(string); ok {
+			return []any{"trace_id", id}
+		}
+		return nil
+	})
+
+	ctx := context.WithValue(context.Background(), traceIDKey{}, "abc123")
+	DebugCtx(ctx, "request handled")
+
+	out := buf.String()
+	require.Contains(t, out, "request handled")
+	require.Contains(t, out, "trace_id=abc123")
+}
+
+type traceIDKey struct{}
+
+func TestInfofCtxSuffixSurvivesPercentInValue(t *testing.T) {
+	buf := new(bytes.Buffer)
+	SetOutput(buf)
+	SetLevel(DEBUG)
+	SetFlags(0)
+	SetPrefix("")
+
+	RegisterContextExtractor(func(ctx context.Context) []any {
+		if path, ok := ctx.Value(pathKey{}).(string); ok {
+			return []any{"path", path}
+		}
+		return nil
+	})
+
+	ctx := context.WithValue(context.Background(), pathKey{}, "/a%sb")
+	InfofCtx(ctx, "got request id=%d", 42)
+
+	out := buf.String()
+	require.Contains(t, out, "got request id=42")
+	require.Contains(t, out, "path=/a%sb")
+}
+
+type pathKey struct{}
@@ -0,0 +1,388 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// filterConfig holds the drop/redact rules shared by Filter and
+// StructuredFilter, so the two only differ in which logger interface they
+// wrap.
+type filterConfig struct {
+	minLevel  Level
+	keys      map[string]struct{}
+	values    map[string]struct{}
+	redactStr string
+	pred      func(lv Level, args ...any) bool
+}
+
+// FilterOption configures a Filter or StructuredFilter built by NewFilter
+// or NewStructuredFilter.
+type FilterOption func(*filterConfig)
+
+// FilterLevel raises the filter's minimum level; records below lv are
+// dropped before reaching the wrapped logger.
+func FilterLevel(lv Level) FilterOption {
+	return func(c *filterConfig) { c.minLevel = lv }
+}
+
+// FilterKey redacts the value immediately following any of keys when args
+// are passed as keyval pairs (key1, value1, key2, value2, ...).
+func FilterKey(keys ...string) FilterOption {
+	return func(c *filterConfig) {
+		for _, k := range keys {
+			c.keys[k] = struct{}{}
+		}
+	}
+}
+
+// FilterValue redacts any arg whose string form matches one of values.
+func FilterValue(values ...string) FilterOption {
+	return func(c *filterConfig) {
+		for _, v := range values {
+			c.values[v] = struct{}{}
+		}
+	}
+}
+
+// FilterFunc drops a record whenever fn returns true for its level and args.
+func FilterFunc(fn func(lv Level, args ...any) bool) FilterOption {
+	return func(c *filterConfig) { c.pred = fn }
+}
+
+// FilterRedactWith overrides the default "***" redaction string.
+func FilterRedactWith(s string) FilterOption {
+	return func(c *filterConfig) { c.redactStr = s }
+}
+
+// newFilterConfig builds a filterConfig with opts applied over its
+// defaults.
+func newFilterConfig(opts ...FilterOption) filterConfig {
+	c := filterConfig{
+		minLevel:  DEBUG,
+		keys:      make(map[string]struct{}),
+		values:    make(map[string]struct{}),
+		redactStr: "***",
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// Filter wraps a Logger and drops or redacts records before they reach it,
+// based on composable FilterOptions. It satisfies the Logger interface, so
+// it can be installed with SetLogger like any other logger.
+type Filter struct {
+	next Logger
+	filterConfig
+}
+
+// NewFilter wraps l so that records are dropped or redacted according to
+// opts before being forwarded to l.
+func NewFilter(l Logger, opts ...FilterOption) Logger {
+	return &Filter{next: l, filterConfig: newFilterConfig(opts...)}
+}
+
+// allow reports whether a record at lv with args should reach the wrapped
+// logger.
+func (c *filterConfig) allow(lv Level, args ...any) bool {
+	if lv < c.minLevel {
+		return false
+	}
+	if c.pred != nil && c.pred(lv, args...) {
+		return false
+	}
+	return true
+}
+
+// redact returns a copy of args with matching keys/values replaced by the
+// redaction string. A key match also redacts the value that follows it.
+// For structured calls, args is a keyval slice (key1, value1, key2,
+// value2, ...), so the same key/value matching applies to both a Filter's
+// Info-style args and a StructuredFilter's keyvals.
+func (c *filterConfig) redact(args []any) []any {
+	if len(c.keys) == 0 && len(c.values) == 0 {
+		return args
+	}
+	out := make([]any, len(args))
+	copy(out, args)
+	for i, a := range out {
+		s, ok := stringOf(a)
+		if !ok {
+			continue
+		}
+		if _, matched := c.values[s]; matched {
+			out[i] = c.redactStr
+			continue
+		}
+		if _, isKey := c.keys[s]; isKey && i+1 < len(out) {
+			out[i+1] = c.redactStr
+		}
+	}
+	return out
+}
+
+// stringOf returns the string form of v when v is a string or
+// fmt.Stringer, and whether v was either.
+func stringOf(v any) (string, bool) {
+	switch t := v.(type) {
+	case string:
+		return t, true
+	case fmt.Stringer:
+		return t.String(), true
+	default:
+		return "", false
+	}
+}
+
+func (f *Filter) Debug(args ...any) {
+	if !f.allow(DEBUG, args...) {
+		return
+	}
+	f.next.Debug(f.redact(args)...)
+}
+
+func (f *Filter) Info(args ...any) {
+	if !f.allow(INFO, args...) {
+		return
+	}
+	f.next.Info(f.redact(args)...)
+}
+
+func (f *Filter) Warn(args ...any) {
+	if !f.allow(WARN, args...) {
+		return
+	}
+	f.next.Warn(f.redact(args)...)
+}
+
+func (f *Filter) Error(args ...any) {
+	if !f.allow(ERROR, args...) {
+		return
+	}
+	f.next.Error(f.redact(args)...)
+}
+
+func (f *Filter) Fatal(args ...any) {
+	if !f.allow(FATAL, args...) {
+		return
+	}
+	f.next.Fatal(f.redact(args)...)
+}
+
+func (f *Filter) Debugf(format string, args ...any) {
+	if !f.allow(DEBUG, args...) {
+		return
+	}
+	f.next.Debugf(format, f.redact(args)...)
+}
+
+func (f *Filter) Infof(format string, args ...any) {
+	if !f.allow(INFO, args...) {
+		return
+	}
+	f.next.Infof(format, f.redact(args)...)
+}
+
+func (f *Filter) Warnf(format string, args ...any) {
+	if !f.allow(WARN, args...) {
+		return
+	}
+	f.next.Warnf(format, f.redact(args)...)
+}
+
+func (f *Filter) Errorf(format string, args ...any) {
+	if !f.allow(ERROR, args...) {
+		return
+	}
+	f.next.Errorf(format, f.redact(args)...)
+}
+
+func (f *Filter) Fatalf(format string, args ...any) {
+	if !f.allow(FATAL, args...) {
+		return
+	}
+	f.next.Fatalf(format, f.redact(args)...)
+}
+
+// redactCtx redacts args and, separately, ctx's extracted keyvals,
+// rendering the (now redacted) keyvals as a trailing suffix. The wrapped
+// logger's own *Ctx methods must not be allowed to extract ctx again
+// after this: they'd pull in the raw, unredacted keyvals a second time,
+// defeating FilterKey/FilterValue for anything a ContextExtractor adds.
+// So callers pass the result to the wrapped logger's non-Ctx method
+// instead of forwarding ctx.
+func (f *Filter) redactCtx(ctx context.Context, args []any) []any {
+	out := f.redact(args)
+	keyvals := extractContext(ctx)
+	if len(keyvals) == 0 {
+		return out
+	}
+	suffix := renderKeyvals(f.redact(keyvals))
+	if suffix == "" {
+		return out
+	}
+	return append(out, suffix)
+}
+
+// redactCtxf is redactCtx's counterpart for the f-suffixed Ctx methods: it
+// appends the redacted suffix as a trailing "%v" verb plus arg rather
+// than concatenating it into format (see appendCtxFormatSuffix).
+func (f *Filter) redactCtxf(ctx context.Context, format string, args []any) (string, []any) {
+	redactedArgs := f.redact(args)
+	keyvals := extractContext(ctx)
+	if len(keyvals) == 0 {
+		return format, redactedArgs
+	}
+	suffix := renderKeyvals(f.redact(keyvals))
+	if suffix == "" {
+		return format, redactedArgs
+	}
+	return format + "%v", append(redactedArgs, suffix)
+}
+
+func (f *Filter) DebugCtx(ctx context.Context, args ...any) {
+	if !f.allow(DEBUG, args...) {
+		return
+	}
+	f.next.Debug(f.redactCtx(ctx, args)...)
+}
+
+func (f *Filter) InfoCtx(ctx context.Context, args ...any) {
+	if !f.allow(INFO, args...) {
+		return
+	}
+	f.next.Info(f.redactCtx(ctx, args)...)
+}
+
+func (f *Filter) WarnCtx(ctx context.Context, args ...any) {
+	if !f.allow(WARN, args...) {
+		return
+	}
+	f.next.Warn(f.redactCtx(ctx, args)...)
+}
+
+func (f *Filter) ErrorCtx(ctx context.Context, args ...any) {
+	if !f.allow(ERROR, args...) {
+		return
+	}
+	f.next.Error(f.redactCtx(ctx, args)...)
+}
+
+func (f *Filter) FatalCtx(ctx context.Context, args ...any) {
+	if !f.allow(FATAL, args...) {
+		return
+	}
+	f.next.Fatal(f.redactCtx(ctx, args)...)
+}
+
+func (f *Filter) DebugfCtx(ctx context.Context, format string, args ...any) {
+	if !f.allow(DEBUG, args...) {
+		return
+	}
+	rFormat, rArgs := f.redactCtxf(ctx, format, args)
+	f.next.Debugf(rFormat, rArgs...)
+}
+
+func (f *Filter) InfofCtx(ctx context.Context, format string, args ...any) {
+	if !f.allow(INFO, args...) {
+		return
+	}
+	rFormat, rArgs := f.redactCtxf(ctx, format, args)
+	f.next.Infof(rFormat, rArgs...)
+}
+
+func (f *Filter) WarnfCtx(ctx context.Context, format string, args ...any) {
+	if !f.allow(WARN, args...) {
+		return
+	}
+	rFormat, rArgs := f.redactCtxf(ctx, format, args)
+	f.next.Warnf(rFormat, rArgs...)
+}
+
+func (f *Filter) ErrorfCtx(ctx context.Context, format string, args ...any) {
+	if !f.allow(ERROR, args...) {
+		return
+	}
+	rFormat, rArgs := f.redactCtxf(ctx, format, args)
+	f.next.Errorf(rFormat, rArgs...)
+}
+
+func (f *Filter) FatalfCtx(ctx context.Context, format string, args ...any) {
+	if !f.allow(FATAL, args...) {
+		return
+	}
+	rFormat, rArgs := f.redactCtxf(ctx, format, args)
+	f.next.Fatalf(rFormat, rArgs...)
+}
+
+func (f *Filter) SetLevel(lv Level)       { f.next.SetLevel(lv) }
+func (f *Filter) SetOutput(w io.Writer)   { f.next.SetOutput(w) }
+func (f *Filter) SetPrefix(prefix string) { f.next.SetPrefix(prefix) }
+func (f *Filter) SetFlags(flag int)       { f.next.SetFlags(flag) }
+
+// StructuredFilter wraps a StructuredLogger and drops or redacts keyvals
+// before they reach it, using the same FilterOptions as Filter. A Filter
+// wrapping a structured logger through AsLogger matches the classic args,
+// which AsLogger then renders into the message string; StructuredFilter
+// instead inspects the keyval pairs of the structured calls, which reach
+// the wrapped logger as real fields.
+type StructuredFilter struct {
+	next StructuredLogger
+	filterConfig
+}
+
+// NewStructuredFilter wraps l so that keyvals are dropped or redacted
+// according to opts before being forwarded to l.
+func NewStructuredFilter(l StructuredLogger, opts ...FilterOption) StructuredLogger {
+	return &StructuredFilter{next: l, filterConfig: newFilterConfig(opts...)}
+}
+
+func (f *StructuredFilter) Debugw(msg string, keyvals ...any) {
+	if !f.allow(DEBUG, keyvals...) {
+		return
+	}
+	f.next.Debugw(msg, f.redact(keyvals)...)
+}
+
+func (f *StructuredFilter) Infow(msg string, keyvals ...any) {
+	if !f.allow(INFO, keyvals...) {
+		return
+	}
+	f.next.Infow(msg, f.redact(keyvals)...)
+}
+
+func (f *StructuredFilter) Warnw(msg string, keyvals ...any) {
+	if !f.allow(WARN, keyvals...) {
+		return
+	}
+	f.next.Warnw(msg, f.redact(keyvals)...)
+}
+
+func (f *StructuredFilter) Errorw(msg string, keyvals ...any) {
+	if !f.allow(ERROR, keyvals...) {
+		return
+	}
+	f.next.Errorw(msg, f.redact(keyvals)...)
+}
+
+func (f *StructuredFilter) Fatalw(msg string, keyvals ...any) {
+	if !f.allow(FATAL, keyvals...) {
+		return
+	}
+	f.next.Fatalw(msg, f.redact(keyvals)...)
+}
+
+func (f *StructuredFilter) SetLevel(lv Level) { f.next.SetLevel(lv) }
+
+// With returns a child StructuredFilter wrapping the next logger's own
+// With, redacting the persistent keyvals the same way as a one-off call.
+func (f *StructuredFilter) With(keyvals ...any) StructuredLogger {
+	return &StructuredFilter{next: f.next.With(f.redact(keyvals)...), filterConfig: f.filterConfig}
+}
+
+func (f *StructuredFilter) WithGroup(name string) StructuredLogger {
+	return &StructuredFilter{next: f.next.WithGroup(name), filterConfig: f.filterConfig}
+}
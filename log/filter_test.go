@@ -0,0 +1,129 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterLevel(t *testing.T) {
+	buf := new(bytes.Buffer)
+	base := NewText(buf, DEBUG)
+	f := NewFilter(AsLogger(base), FilterLevel(WARN))
+
+	f.Info("quiet")
+	require.Empty(t, buf.String())
+
+	f.Warn("loud")
+	require.Contains(t, buf.String(), "loud")
+}
+
+func TestFilterValue(t *testing.T) {
+	buf := new(bytes.Buffer)
+	base := NewText(buf, DEBUG)
+	f := NewFilter(AsLogger(base), FilterValue("s3cr3t"))
+
+	f.Info("token", "s3cr3t")
+	require.NotContains(t, buf.String(), "s3cr3t")
+	require.Contains(t, buf.String(), "***")
+}
+
+func TestFilterKeyRedactsValue(t *testing.T) {
+	buf := new(bytes.Buffer)
+	base := NewText(buf, DEBUG)
+	f := NewFilter(AsLogger(base), FilterKey("password"))
+
+	f.Info("password", "hunter2")
+	require.NotContains(t, buf.String(), "hunter2")
+	require.Contains(t, buf.String(), "***")
+}
+
+type authTokenKey struct{}
+
+func TestFilterKeyRedactsContextExtractedField(t *testing.T) {
+	buf := new(bytes.Buffer)
+	base := NewText(buf, DEBUG)
+	f := NewFilter(AsLogger(base), FilterKey("auth_token"))
+
+	RegisterContextExtractor(func(ctx context.Context) []any {
+		if tok, ok := ctx.Value(authTokenKey{}).(string); ok {
+			return []any{"auth_token", tok}
+		}
+		return nil
+	})
+
+	ctx := context.WithValue(context.Background(), authTokenKey{}, "supersecrettoken")
+	f.InfoCtx(ctx, "handling request")
+
+	out := buf.String()
+	require.NotContains(t, out, "supersecrettoken")
+	require.Contains(t, out, "handling request")
+	require.Contains(t, out, "auth_token=***")
+}
+
+func TestFilterRedactWith(t *testing.T) {
+	buf := new(bytes.Buffer)
+	base := NewText(buf, DEBUG)
+	f := NewFilter(AsLogger(base), FilterValue("secret"), FilterRedactWith("[REDACTED]"))
+
+	f.Info("secret")
+	require.Contains(t, buf.String(), "[REDACTED]")
+}
+
+func TestStructuredFilterKeyRedactsValue(t *testing.T) {
+	buf := new(bytes.Buffer)
+	base := NewText(buf, DEBUG)
+	f := NewStructuredFilter(base, FilterKey("password"))
+
+	f.Infow("login", "password", "hunter2")
+	require.NotContains(t, buf.String(), "hunter2")
+	require.Contains(t, buf.String(), "***")
+}
+
+func TestStructuredFilterValue(t *testing.T) {
+	buf := new(bytes.Buffer)
+	base := NewText(buf, DEBUG)
+	f := NewStructuredFilter(base, FilterValue("s3cr3t"))
+
+	f.Infow("token", "key", "s3cr3t")
+	require.NotContains(t, buf.String(), "s3cr3t")
+	require.Contains(t, buf.String(), "***")
+}
+
+func TestStructuredFilterLevel(t *testing.T) {
+	buf := new(bytes.Buffer)
+	base := NewText(buf, DEBUG)
+	f := NewStructuredFilter(base, FilterLevel(WARN))
+
+	f.Infow("quiet")
+	require.Empty(t, buf.String())
+
+	f.Warnw("loud")
+	require.Contains(t, buf.String(), "loud")
+}
+
+func TestStructuredFilterWithRedactsPersistentField(t *testing.T) {
+	buf := new(bytes.Buffer)
+	base := NewText(buf, DEBUG)
+	f := NewStructuredFilter(base, FilterKey("password"))
+
+	f.With("password", "hunter2").Infow("login")
+	require.NotContains(t, buf.String(), "hunter2")
+	require.Contains(t, buf.String(), "***")
+}
+
+func TestFilterFunc(t *testing.T) {
+	buf := new(bytes.Buffer)
+	base := NewText(buf, DEBUG)
+	f := NewFilter(AsLogger(base), FilterFunc(func(lv Level, args ...any) bool {
+		return lv == DEBUG
+	}))
+
+	f.Debug("dropped")
+	require.Empty(t, buf.String())
+
+	f.Info("kept")
+	require.Contains(t, buf.String(), "kept")
+}
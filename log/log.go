@@ -1,6 +1,7 @@
 package log
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"log"
@@ -89,6 +90,16 @@ type Logger interface {
 	Warnf(format string, args ...any)
 	Errorf(format string, args ...any)
 	Fatalf(format string, args ...any)
+	DebugCtx(ctx context.Context, args ...any)
+	InfoCtx(ctx context.Context, args ...any)
+	WarnCtx(ctx context.Context, args ...any)
+	ErrorCtx(ctx context.Context, args ...any)
+	FatalCtx(ctx context.Context, args ...any)
+	DebugfCtx(ctx context.Context, format string, args ...any)
+	InfofCtx(ctx context.Context, format string, args ...any)
+	WarnfCtx(ctx context.Context, format string, args ...any)
+	ErrorfCtx(ctx context.Context, format string, args ...any)
+	FatalfCtx(ctx context.Context, format string, args ...any)
 	SetLevel(Level)
 	SetOutput(io.Writer)
 	SetPrefix(prefix string)
@@ -185,6 +196,56 @@ func Debugf(format string, args ...any) {
 	logger.Debugf(format, args...)
 }
 
+// FatalCtx cads the default logger's FatalCtx method and then os.Exit(1).
+func FatalCtx(ctx context.Context, args ...any) {
+	logger.FatalCtx(ctx, args...)
+}
+
+// ErrorCtx cads the default logger's ErrorCtx method.
+func ErrorCtx(ctx context.Context, args ...any) {
+	logger.ErrorCtx(ctx, args...)
+}
+
+// WarnCtx cads the default logger's WarnCtx method.
+func WarnCtx(ctx context.Context, args ...any) {
+	logger.WarnCtx(ctx, args...)
+}
+
+// InfoCtx cads the default logger's InfoCtx method.
+func InfoCtx(ctx context.Context, args ...any) {
+	logger.InfoCtx(ctx, args...)
+}
+
+// DebugCtx cads the default logger's DebugCtx method.
+func DebugCtx(ctx context.Context, args ...any) {
+	logger.DebugCtx(ctx, args...)
+}
+
+// FatalfCtx cads the default logger's FatalfCtx method and then os.Exit(1).
+func FatalfCtx(ctx context.Context, format string, args ...any) {
+	logger.FatalfCtx(ctx, format, args...)
+}
+
+// ErrorfCtx cads the default logger's ErrorfCtx method.
+func ErrorfCtx(ctx context.Context, format string, args ...any) {
+	logger.ErrorfCtx(ctx, format, args...)
+}
+
+// WarnfCtx cads the default logger's WarnfCtx method.
+func WarnfCtx(ctx context.Context, format string, args ...any) {
+	logger.WarnfCtx(ctx, format, args...)
+}
+
+// InfofCtx cads the default logger's InfofCtx method.
+func InfofCtx(ctx context.Context, format string, args ...any) {
+	logger.InfofCtx(ctx, format, args...)
+}
+
+// DebugfCtx cads the default logger's DebugfCtx method.
+func DebugfCtx(ctx context.Context, format string, args ...any) {
+	logger.DebugfCtx(ctx, format, args...)
+}
+
 type defaultLogger struct {
 	stdLog *log.Logger
 	level  Level
@@ -210,13 +271,15 @@ func (l *defaultLogger) logf(lv Level, format *string, args ...any) {
 	if lv < l.level {
 		return
 	}
-	msg := lv.String()
+	args = redactArgs(args)
+	var content string
 	if format != nil {
-		msg += fmt.Sprintf(*format, args...)
+		content = fmt.Sprintf(*format, args...)
 	} else {
-		msg += fmt.Sprint(args...)
+		content = fmt.Sprint(args...)
 	}
-	_ = l.stdLog.Output(4, msg)
+	_ = l.stdLog.Output(4, lv.String()+content)
+	backends.Log(newRecord(lv, "", content, args, defaultCallerSkip))
 	if lv == FATAL {
 		os.Exit(1)
 	}
@@ -261,3 +324,51 @@ func (l *defaultLogger) Infof(format string, args ...any) {
 func (l *defaultLogger) Debugf(format string, args ...any) {
 	l.logf(DEBUG, &format, args...)
 }
+
+// DebugCtx through FatalCtx behave like their non-Ctx counterparts, but
+// additionally append any keyvals produced by the registered
+// ContextExtractors as "key=value" suffixes.
+func (l *defaultLogger) DebugCtx(ctx context.Context, args ...any) {
+	l.logf(DEBUG, nil, appendCtxSuffix(ctx, args)...)
+}
+
+func (l *defaultLogger) InfoCtx(ctx context.Context, args ...any) {
+	l.logf(INFO, nil, appendCtxSuffix(ctx, args)...)
+}
+
+func (l *defaultLogger) WarnCtx(ctx context.Context, args ...any) {
+	l.logf(WARN, nil, appendCtxSuffix(ctx, args)...)
+}
+
+func (l *defaultLogger) ErrorCtx(ctx context.Context, args ...any) {
+	l.logf(ERROR, nil, appendCtxSuffix(ctx, args)...)
+}
+
+func (l *defaultLogger) FatalCtx(ctx context.Context, args ...any) {
+	l.logf(FATAL, nil, appendCtxSuffix(ctx, args)...)
+}
+
+func (l *defaultLogger) DebugfCtx(ctx context.Context, format string, args ...any) {
+	f, args := appendCtxFormatSuffix(ctx, format, args)
+	l.logf(DEBUG, &f, args...)
+}
+
+func (l *defaultLogger) InfofCtx(ctx context.Context, format string, args ...any) {
+	f, args := appendCtxFormatSuffix(ctx, format, args)
+	l.logf(INFO, &f, args...)
+}
+
+func (l *defaultLogger) WarnfCtx(ctx context.Context, format string, args ...any) {
+	f, args := appendCtxFormatSuffix(ctx, format, args)
+	l.logf(WARN, &f, args...)
+}
+
+func (l *defaultLogger) ErrorfCtx(ctx context.Context, format string, args ...any) {
+	f, args := appendCtxFormatSuffix(ctx, format, args)
+	l.logf(ERROR, &f, args...)
+}
+
+func (l *defaultLogger) FatalfCtx(ctx context.Context, format string, args ...any) {
+	f, args := appendCtxFormatSuffix(ctx, format, args)
+	l.logf(FATAL, &f, args...)
+}
@@ -259,6 +259,9 @@ func checkOutput(t *testing.T, level Level, args []any, expectArgs string, forma
 }
 
 func TestConfig(t *testing.T) {
+	old := DefaultLogger()
+	defer SetLogger(old)
+
 	require.Equal(t, logger, DefaultLogger())
 	newLog := new(defaultLogger)
 	SetLogger(newLog)
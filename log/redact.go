@@ -0,0 +1,41 @@
+package log
+
+import "strings"
+
+// Redactor is implemented by values that should never appear verbatim in
+// logs. The default logger replaces any arg implementing it with the
+// result of Redacted() before formatting.
+type Redactor interface {
+	Redacted() any
+}
+
+// Redact returns a same-length string of asterisks, handy for implementing
+// Redactor.Redacted on string-like secrets.
+func Redact(s string) string {
+	return strings.Repeat("*", len(s))
+}
+
+// redactArgs replaces any Redactor in args with its Redacted() value. It
+// takes a fast path that returns args unmodified when none are present, so
+// calls with no sensitive values pay no extra allocation.
+func redactArgs(args []any) []any {
+	redact := false
+	for _, a := range args {
+		if _, ok := a.(Redactor); ok {
+			redact = true
+			break
+		}
+	}
+	if !redact {
+		return args
+	}
+	out := make([]any, len(args))
+	for i, a := range args {
+		if r, ok := a.(Redactor); ok {
+			out[i] = r.Redacted()
+		} else {
+			out[i] = a
+		}
+	}
+	return out
+}
@@ -0,0 +1,46 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type password string
+
+func (p password) Redacted() any { return Redact(string(p)) }
+
+func TestRedactFunc(t *testing.T) {
+	require.Equal(t, "******", Redact("secret"))
+	require.Equal(t, "", Redact(""))
+}
+
+func TestLoggerRedactsInfo(t *testing.T) {
+	buf := new(bytes.Buffer)
+	SetOutput(buf)
+	SetLevel(INFO)
+	SetFlags(0)
+	SetPrefix("")
+
+	Info("user=alice pw=", password("hunter2"))
+
+	out := buf.String()
+	require.NotContains(t, out, "hunter2")
+	require.Contains(t, out, "*******")
+}
+
+func TestLoggerRedactsInfof(t *testing.T) {
+	buf := new(bytes.Buffer)
+	SetOutput(buf)
+	SetLevel(INFO)
+	SetFlags(0)
+	SetPrefix("")
+
+	Infof("user=%s pw=%s", "alice", password("hunter2"))
+
+	out := buf.String()
+	require.NotContains(t, out, "hunter2")
+	require.Contains(t, out, "*******")
+	require.Contains(t, out, "user=alice")
+}
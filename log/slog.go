@@ -0,0 +1,211 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+)
+
+// levelToSlog maps this package's Level onto the slog.Level with the same
+// relative ordering, so a slog.Handler filters identically to SetLevel.
+func levelToSlog(lv Level) slog.Level {
+	switch lv {
+	case DEBUG:
+		return slog.LevelDebug
+	case INFO:
+		return slog.LevelInfo
+	case WARN:
+		return slog.LevelWarn
+	case ERROR:
+		return slog.LevelError
+	case FATAL:
+		return slog.Level(12)
+	default:
+		return slog.LevelError
+	}
+}
+
+// StructuredLogger is a logger interface that pairs a message with
+// alternating key/value fields, in the style of log/slog.
+type StructuredLogger interface {
+	Debugw(msg string, keyvals ...any)
+	Infow(msg string, keyvals ...any)
+	Warnw(msg string, keyvals ...any)
+	Errorw(msg string, keyvals ...any)
+	Fatalw(msg string, keyvals ...any)
+	SetLevel(Level)
+
+	// With returns a child StructuredLogger that carries keyvals on every
+	// subsequent record.
+	With(keyvals ...any) StructuredLogger
+	// WithGroup returns a child StructuredLogger that nests subsequent
+	// fields (including those added by With) under name.
+	WithGroup(name string) StructuredLogger
+}
+
+// structuredBase implements the common StructuredLogger plumbing on top of
+// a slog.Logger. JSONLogger and TextLogger embed it and only differ in the
+// slog.Handler they were constructed with.
+type structuredBase struct {
+	logger *slog.Logger
+	level  *slog.LevelVar
+}
+
+func newLevelVar(level Level) *slog.LevelVar {
+	lv := new(slog.LevelVar)
+	lv.Set(levelToSlog(level))
+	return lv
+}
+
+func (b *structuredBase) logw(lv Level, msg string, keyvals ...any) {
+	b.logger.Log(context.Background(), levelToSlog(lv), msg, keyvals...)
+	if lv == FATAL {
+		os.Exit(1)
+	}
+}
+
+func (b *structuredBase) SetLevel(lv Level) {
+	b.level.Set(levelToSlog(lv))
+}
+
+// JSONLogger is a StructuredLogger that writes each record as a line of JSON.
+type JSONLogger struct {
+	structuredBase
+}
+
+// NewJSON returns a JSONLogger writing records at or above level to w.
+func NewJSON(w io.Writer, level Level) *JSONLogger {
+	lv := newLevelVar(level)
+	h := slog.NewJSONHandler(w, &slog.HandlerOptions{Level: lv})
+	return &JSONLogger{structuredBase{logger: slog.New(h), level: lv}}
+}
+
+func (l *JSONLogger) Debugw(msg string, keyvals ...any) { l.logw(DEBUG, msg, keyvals...) }
+func (l *JSONLogger) Infow(msg string, keyvals ...any)  { l.logw(INFO, msg, keyvals...) }
+func (l *JSONLogger) Warnw(msg string, keyvals ...any)  { l.logw(WARN, msg, keyvals...) }
+func (l *JSONLogger) Errorw(msg string, keyvals ...any) { l.logw(ERROR, msg, keyvals...) }
+func (l *JSONLogger) Fatalw(msg string, keyvals ...any) { l.logw(FATAL, msg, keyvals...) }
+
+func (l *JSONLogger) With(keyvals ...any) StructuredLogger {
+	return &JSONLogger{structuredBase{logger: l.logger.With(keyvals...), level: l.level}}
+}
+
+func (l *JSONLogger) WithGroup(name string) StructuredLogger {
+	return &JSONLogger{structuredBase{logger: l.logger.WithGroup(name), level: l.level}}
+}
+
+// TextLogger is a StructuredLogger that writes each record as a line of
+// logfmt-style text (key=value pairs).
+type TextLogger struct {
+	structuredBase
+}
+
+// NewText returns a TextLogger writing records at or above level to w.
+func NewText(w io.Writer, level Level) *TextLogger {
+	lv := newLevelVar(level)
+	h := slog.NewTextHandler(w, &slog.HandlerOptions{Level: lv})
+	return &TextLogger{structuredBase{logger: slog.New(h), level: lv}}
+}
+
+func (l *TextLogger) Debugw(msg string, keyvals ...any) { l.logw(DEBUG, msg, keyvals...) }
+func (l *TextLogger) Infow(msg string, keyvals ...any)  { l.logw(INFO, msg, keyvals...) }
+func (l *TextLogger) Warnw(msg string, keyvals ...any)  { l.logw(WARN, msg, keyvals...) }
+func (l *TextLogger) Errorw(msg string, keyvals ...any) { l.logw(ERROR, msg, keyvals...) }
+func (l *TextLogger) Fatalw(msg string, keyvals ...any) { l.logw(FATAL, msg, keyvals...) }
+
+func (l *TextLogger) With(keyvals ...any) StructuredLogger {
+	return &TextLogger{structuredBase{logger: l.logger.With(keyvals...), level: l.level}}
+}
+
+func (l *TextLogger) WithGroup(name string) StructuredLogger {
+	return &TextLogger{structuredBase{logger: l.logger.WithGroup(name), level: l.level}}
+}
+
+// structuredLoggerAdapter exposes a StructuredLogger through the classic
+// Logger interface, so it can be installed with SetLogger. Persistent
+// fields attached via With are rendered by the underlying slog.Handler
+// (key=value pairs for TextLogger, object fields for JSONLogger).
+type structuredLoggerAdapter struct {
+	sl StructuredLogger
+}
+
+// AsLogger adapts a StructuredLogger to the classic Logger interface.
+func AsLogger(sl StructuredLogger) Logger {
+	return &structuredLoggerAdapter{sl: sl}
+}
+
+func (a *structuredLoggerAdapter) Debug(args ...any) { a.sl.Debugw(fmt.Sprint(args...)) }
+func (a *structuredLoggerAdapter) Info(args ...any)  { a.sl.Infow(fmt.Sprint(args...)) }
+func (a *structuredLoggerAdapter) Warn(args ...any)  { a.sl.Warnw(fmt.Sprint(args...)) }
+func (a *structuredLoggerAdapter) Error(args ...any) { a.sl.Errorw(fmt.Sprint(args...)) }
+func (a *structuredLoggerAdapter) Fatal(args ...any) { a.sl.Fatalw(fmt.Sprint(args...)) }
+
+func (a *structuredLoggerAdapter) Debugf(format string, args ...any) {
+	a.sl.Debugw(fmt.Sprintf(format, args...))
+}
+func (a *structuredLoggerAdapter) Infof(format string, args ...any) {
+	a.sl.Infow(fmt.Sprintf(format, args...))
+}
+func (a *structuredLoggerAdapter) Warnf(format string, args ...any) {
+	a.sl.Warnw(fmt.Sprintf(format, args...))
+}
+func (a *structuredLoggerAdapter) Errorf(format string, args ...any) {
+	a.sl.Errorw(fmt.Sprintf(format, args...))
+}
+func (a *structuredLoggerAdapter) Fatalf(format string, args ...any) {
+	a.sl.Fatalw(fmt.Sprintf(format, args...))
+}
+
+// DebugCtx through FatalfCtx pass any keyvals produced by the registered
+// ContextExtractors through to the underlying StructuredLogger as native
+// slog attributes, rather than stringifying them.
+func (a *structuredLoggerAdapter) DebugCtx(ctx context.Context, args ...any) {
+	a.sl.Debugw(fmt.Sprint(args...), extractContext(ctx)...)
+}
+
+func (a *structuredLoggerAdapter) InfoCtx(ctx context.Context, args ...any) {
+	a.sl.Infow(fmt.Sprint(args...), extractContext(ctx)...)
+}
+
+func (a *structuredLoggerAdapter) WarnCtx(ctx context.Context, args ...any) {
+	a.sl.Warnw(fmt.Sprint(args...), extractContext(ctx)...)
+}
+
+func (a *structuredLoggerAdapter) ErrorCtx(ctx context.Context, args ...any) {
+	a.sl.Errorw(fmt.Sprint(args...), extractContext(ctx)...)
+}
+
+func (a *structuredLoggerAdapter) FatalCtx(ctx context.Context, args ...any) {
+	a.sl.Fatalw(fmt.Sprint(args...), extractContext(ctx)...)
+}
+
+func (a *structuredLoggerAdapter) DebugfCtx(ctx context.Context, format string, args ...any) {
+	a.sl.Debugw(fmt.Sprintf(format, args...), extractContext(ctx)...)
+}
+
+func (a *structuredLoggerAdapter) InfofCtx(ctx context.Context, format string, args ...any) {
+	a.sl.Infow(fmt.Sprintf(format, args...), extractContext(ctx)...)
+}
+
+func (a *structuredLoggerAdapter) WarnfCtx(ctx context.Context, format string, args ...any) {
+	a.sl.Warnw(fmt.Sprintf(format, args...), extractContext(ctx)...)
+}
+
+func (a *structuredLoggerAdapter) ErrorfCtx(ctx context.Context, format string, args ...any) {
+	a.sl.Errorw(fmt.Sprintf(format, args...), extractContext(ctx)...)
+}
+
+func (a *structuredLoggerAdapter) FatalfCtx(ctx context.Context, format string, args ...any) {
+	a.sl.Fatalw(fmt.Sprintf(format, args...), extractContext(ctx)...)
+}
+
+func (a *structuredLoggerAdapter) SetLevel(lv Level) { a.sl.SetLevel(lv) }
+
+// SetOutput, SetPrefix and SetFlags have no slog equivalent (the handler
+// owns its writer and slog records carry neither a prefix nor stdlib-log
+// style flags), so they are no-ops for a structured logger.
+func (a *structuredLoggerAdapter) SetOutput(io.Writer) {}
+func (a *structuredLoggerAdapter) SetPrefix(string)    {}
+func (a *structuredLoggerAdapter) SetFlags(int)        {}
@@ -0,0 +1,58 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONLogger(t *testing.T) {
+	buf := new(bytes.Buffer)
+	l := NewJSON(buf, INFO)
+
+	l.Debugw("hidden", "k", "v")
+	require.Empty(t, buf.String())
+
+	l.Infow("hello", "user", "alice")
+	var rec map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &rec))
+	require.Equal(t, "hello", rec["msg"])
+	require.Equal(t, "alice", rec["user"])
+}
+
+func TestTextLoggerWith(t *testing.T) {
+	buf := new(bytes.Buffer)
+	l := NewText(buf, DEBUG)
+	child := l.With("request_id", "abc123")
+
+	child.Infow("started")
+	out := buf.String()
+	require.Contains(t, out, "msg=started")
+	require.Contains(t, out, "request_id=abc123")
+}
+
+func TestStructuredSetLevel(t *testing.T) {
+	buf := new(bytes.Buffer)
+	l := NewText(buf, ERROR)
+
+	l.Infow("quiet")
+	require.Empty(t, buf.String())
+
+	l.SetLevel(INFO)
+	l.Infow("loud")
+	require.Contains(t, buf.String(), "msg=loud")
+}
+
+func TestAsLogger(t *testing.T) {
+	buf := new(bytes.Buffer)
+	sl := NewText(buf, DEBUG).With("service", "utility")
+	classic := AsLogger(sl)
+
+	classic.Infof("value=%d", 42)
+	out := buf.String()
+	require.True(t, strings.Contains(out, "value=42"))
+	require.True(t, strings.Contains(out, "service=utility"))
+}